@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+)
+
+func dataSourceIBMPIIKEPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIIKEPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			helpers.PICloudInstanceId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PI cloud instance ID",
+			},
+
+			// Computed Attributes
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of IKE Policies",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						PIPolicyId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IKE policy ID",
+						},
+						helpers.PIVPNPolicyName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the IKE Policy",
+						},
+						helpers.PIVPNPolicyDhGroup: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "DH group of the IKE Policy",
+						},
+						helpers.PIVPNPolicyEncryption: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Encryption of the IKE Policy",
+						},
+						PIVPNPolicyVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Version of the IKE Policy",
+						},
+						helpers.PIVPNPolicyKeyLifetime: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Policy key lifetime",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIIKEPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+
+	client := st.NewIBMPIVpnPolicyClient(sess, cloudInstanceID)
+	ikePolicies, err := client.GetAllIKEPoliciesWithContext(ctx, cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(ikePolicies.Policies))
+	for _, policy := range ikePolicies.Policies {
+		policyInfo := map[string]interface{}{
+			PIPolicyId:                     *policy.ID,
+			helpers.PIVPNPolicyName:        policy.Name,
+			helpers.PIVPNPolicyDhGroup:     policy.DhGroup,
+			helpers.PIVPNPolicyEncryption:  policy.Encryption,
+			PIVPNPolicyVersion:             policy.Version,
+			helpers.PIVPNPolicyKeyLifetime: policy.KeyLifetime,
+		}
+		result = append(result, policyInfo)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("policies", result)
+
+	return nil
+}
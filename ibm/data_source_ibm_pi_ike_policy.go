@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+)
+
+func dataSourceIBMPIIKEPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIIKEPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			helpers.PICloudInstanceId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PI cloud instance ID",
+			},
+			PIPolicyId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IKE policy ID",
+			},
+
+			// Computed Attributes
+			helpers.PIVPNPolicyName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the IKE Policy",
+			},
+			helpers.PIVPNPolicyDhGroup: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "DH group of the IKE Policy",
+			},
+			helpers.PIVPNPolicyEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Encryption of the IKE Policy",
+			},
+			PIVPNPolicyVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Version of the IKE Policy",
+			},
+			helpers.PIVPNPolicyKeyLifetime: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Policy key lifetime",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIIKEPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+	policyID := d.Get(PIPolicyId).(string)
+
+	client := st.NewIBMPIVpnPolicyClient(sess, cloudInstanceID)
+	ikePolicy, err := client.GetIKEPolicyWithContext(ctx, policyID, cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*ikePolicy.ID)
+	d.Set(helpers.PIVPNPolicyName, ikePolicy.Name)
+	d.Set(helpers.PIVPNPolicyDhGroup, ikePolicy.DhGroup)
+	d.Set(helpers.PIVPNPolicyEncryption, ikePolicy.Encryption)
+	d.Set(PIVPNPolicyVersion, ikePolicy.Version)
+	d.Set(helpers.PIVPNPolicyKeyLifetime, ikePolicy.KeyLifetime)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+)
+
+func dataSourceIBMPIIPSecPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIIPSecPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			helpers.PICloudInstanceId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PI cloud instance ID",
+			},
+
+			// Computed Attributes
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of IPSec Policies",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						PIPolicyId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IPSec policy ID",
+						},
+						helpers.PIVPNPolicyName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the IPSec Policy",
+						},
+						helpers.PIVPNPolicyDhGroup: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "DH group of the IPSec Policy",
+						},
+						helpers.PIVPNPolicyEncryption: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Encryption of the IPSec Policy",
+						},
+						helpers.PIVPNPolicyKeyLifetime: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Policy key lifetime",
+						},
+						helpers.PIVPNPolicyPFS: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Perfect Forward Secrecy",
+						},
+						helpers.PIVPNPolicyAuthentication: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Authentication for the IPSec Policy",
+						},
+						PIVPNPolicyVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "IKE protocol version this IPSec Policy is paired with",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIIPSecPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+
+	client := st.NewIBMPIVpnPolicyClient(sess, cloudInstanceID)
+	ipsecPolicies, err := client.GetAllIPSecPoliciesWithContext(ctx, cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(ipsecPolicies.Policies))
+	for _, policy := range ipsecPolicies.Policies {
+		policyInfo := map[string]interface{}{
+			PIPolicyId:                        *policy.ID,
+			helpers.PIVPNPolicyName:           policy.Name,
+			helpers.PIVPNPolicyDhGroup:        policy.DhGroup,
+			helpers.PIVPNPolicyEncryption:     policy.Encryption,
+			helpers.PIVPNPolicyKeyLifetime:    policy.KeyLifetime,
+			helpers.PIVPNPolicyPFS:            policy.Pfs,
+			helpers.PIVPNPolicyAuthentication: policy.Authentication,
+			PIVPNPolicyVersion:                policy.Version,
+		}
+		result = append(result, policyInfo)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("policies", result)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+func dataSourceIBMPIIPSecPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIIPSecPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			helpers.PICloudInstanceId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PI cloud instance ID",
+			},
+			PIPolicyId: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IPSec policy ID. Either pi_policy_id or pi_policy_name must be provided",
+			},
+			helpers.PIVPNPolicyName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the IPSec Policy. Either pi_policy_name or pi_policy_id must be provided",
+			},
+
+			// Computed Attributes
+			helpers.PIVPNPolicyDhGroup: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "DH group of the IPSec Policy",
+			},
+			helpers.PIVPNPolicyEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Encryption of the IPSec Policy",
+			},
+			helpers.PIVPNPolicyKeyLifetime: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Policy key lifetime",
+			},
+			helpers.PIVPNPolicyPFS: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Perfect Forward Secrecy",
+			},
+			helpers.PIVPNPolicyAuthentication: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Authentication for the IPSec Policy",
+			},
+			PIVPNPolicyVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "IKE protocol version this IPSec Policy is paired with",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIIPSecPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+	client := st.NewIBMPIVpnPolicyClient(sess, cloudInstanceID)
+
+	var ipsecPolicy *models.IPSecPolicy
+	if v, ok := d.GetOk(PIPolicyId); ok {
+		policy, err := client.GetIPSecPolicyWithContext(ctx, v.(string), cloudInstanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ipsecPolicy = policy
+	} else if v, ok := d.GetOk(helpers.PIVPNPolicyName); ok {
+		name := v.(string)
+		policies, err := client.GetAllIPSecPoliciesWithContext(ctx, cloudInstanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, policy := range policies.Policies {
+			if policy.Name != nil && *policy.Name == name {
+				ipsecPolicy = policy
+				break
+			}
+		}
+		if ipsecPolicy == nil {
+			return diag.Errorf("no IPSec policy found with name %s", name)
+		}
+	} else {
+		return diag.Errorf("one of %s or %s must be provided", PIPolicyId, helpers.PIVPNPolicyName)
+	}
+
+	d.SetId(*ipsecPolicy.ID)
+	d.Set(helpers.PIVPNPolicyName, ipsecPolicy.Name)
+	d.Set(helpers.PIVPNPolicyDhGroup, ipsecPolicy.DhGroup)
+	d.Set(helpers.PIVPNPolicyEncryption, ipsecPolicy.Encryption)
+	d.Set(helpers.PIVPNPolicyKeyLifetime, ipsecPolicy.KeyLifetime)
+	d.Set(helpers.PIVPNPolicyPFS, ipsecPolicy.Pfs)
+	d.Set(helpers.PIVPNPolicyAuthentication, ipsecPolicy.Authentication)
+	d.Set(PIVPNPolicyVersion, ipsecPolicy.Version)
+
+	return nil
+}
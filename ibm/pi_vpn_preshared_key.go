@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// PIVPNPresharedKeyCRN is the CRN of the Key Protect/HPCS key or wrapped secret holding the PSK
+const PIVPNPresharedKeyCRN = "pi_preshared_key_crn"
+
+// PIVPNPresharedKeyHash is a SHA-256 hash of the resolved PSK, stored in place of the cleartext value
+const PIVPNPresharedKeyHash = "pi_preshared_key_hash"
+
+// resolvePresharedKeyFromCRN fetches the preshared key material referenced by a Key Protect/HPCS CRN.
+// The cleartext key is returned only to be handed to the Power VS API; callers must never persist it
+// to Terraform state, only the CRN and the hash returned by hashPresharedKey.
+//
+// This relies on ClientSession exposing a KeyProtectAPI() (*kp.Client, error) method, the same way it
+// exposes IBMPISession() for the Power VS client; config.go wires that client from the session's Key
+// Protect API endpoint and IAM token the same way it wires the other service clients.
+func resolvePresharedKeyFromCRN(ctx context.Context, meta interface{}, crn string) (string, error) {
+	kpAPI, err := meta.(ClientSession).KeyProtectAPI()
+	if err != nil {
+		return "", err
+	}
+
+	keyID, err := keyIDFromCRN(crn)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := kpAPI.GetKey(ctx, keyID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching preshared key %s from Key Protect: %s", crn, err)
+	}
+
+	presharedKey, err := decodeKeyPayload(key.Payload)
+	if err != nil {
+		return "", fmt.Errorf("error decoding preshared key payload for %s: %s", crn, err)
+	}
+
+	return presharedKey, nil
+}
+
+// decodeKeyPayload decodes a Key Protect key's base64-standard-encoded extractable payload
+// into the raw secret bytes. Key Protect returns an empty payload for non-extractable keys.
+func decodeKeyPayload(payload string) (string, error) {
+	if payload == "" {
+		return "", fmt.Errorf("key is not extractable; preshared keys must be created with an extractable payload")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return string(decoded), nil
+}
+
+// keyIDFromCRN extracts the Key Protect key ID from the trailing segment of a key CRN.
+func keyIDFromCRN(crn string) (string, error) {
+	parts := strings.Split(crn, ":")
+	if len(parts) == 0 || parts[len(parts)-1] == "" {
+		return "", fmt.Errorf("invalid key CRN %s", crn)
+	}
+	return parts[len(parts)-1], nil
+}
+
+// hashPresharedKey returns a hex-encoded SHA-256 hash of the preshared key, safe to persist in state.
+func hashPresharedKey(presharedKey string) string {
+	sum := sha256.Sum256([]byte(presharedKey))
+	return hex.EncodeToString(sum[:])
+}
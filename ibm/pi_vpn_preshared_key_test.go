@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import "testing"
+
+func TestKeyIDFromCRN(t *testing.T) {
+	testCases := []struct {
+		name    string
+		crn     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "standard key protect crn",
+			crn:  "crn:v1:bluemix:public:kms:us-south:a/account-id:instance-id:key:key-id",
+			want: "key-id",
+		},
+		{
+			name:    "empty crn",
+			crn:     "",
+			wantErr: true,
+		},
+		{
+			name:    "trailing colon",
+			crn:     "crn:v1:bluemix:public:kms:us-south:a/account-id:instance-id:key:",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := keyIDFromCRN(tc.crn)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodeKeyPayload(t *testing.T) {
+	testCases := []struct {
+		name    string
+		payload string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "non-extractable key has an empty payload",
+			payload: "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid base64 payload",
+			payload: "not-valid-base64!",
+			wantErr: true,
+		},
+		{
+			name:    "valid base64 payload decodes to the raw secret",
+			payload: "c3VwZXItc2VjcmV0LXBzaw==", // base64("super-secret-psk")
+			want:    "super-secret-psk",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := decodeKeyPayload(tc.payload)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHashPresharedKey(t *testing.T) {
+	hash := hashPresharedKey("super-secret-psk")
+	if hash == "" {
+		t.Fatalf("expected a non-empty hash")
+	}
+	if hash == "super-secret-psk" {
+		t.Fatalf("hash must not equal the cleartext preshared key")
+	}
+	if got := hashPresharedKey("super-secret-psk"); got != hash {
+		t.Fatalf("hash is not deterministic: got %q, want %q", got, hash)
+	}
+	if hashPresharedKey("a-different-psk") == hash {
+		t.Fatalf("expected different preshared keys to produce different hashes")
+	}
+}
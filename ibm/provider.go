@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider registers the resources and data sources added by the PowerVS VPN
+// policy/connection work. The full provider registers many more resources and
+// data sources, plus the provider-level Schema and ConfigureContextFunc; those
+// are out of scope here and are left to the maps they already live in.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"ibm_pi_ike_policy":     resourceIBMPIIKEPolicy(),
+			"ibm_pi_ipsec_policy":   resourceIBMPIIPSecPolicy(),
+			"ibm_pi_vpn_connection": resourceIBMPIVPNConnection(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"ibm_pi_ike_policy":     dataSourceIBMPIIKEPolicy(),
+			"ibm_pi_ike_policies":   dataSourceIBMPIIKEPolicies(),
+			"ibm_pi_ipsec_policy":   dataSourceIBMPIIPSecPolicy(),
+			"ibm_pi_ipsec_policies": dataSourceIBMPIIPSecPolicies(),
+		},
+	}
+}
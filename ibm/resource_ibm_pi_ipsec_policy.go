@@ -18,12 +18,31 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/models"
 )
 
+// PIIPSecPolicyAllowWeakCrypto opts into weak DH groups/authentication algorithms
+const PIIPSecPolicyAllowWeakCrypto = "pi_allow_weak_crypto"
+
+// weakIPSecDhGroups are DH groups considered cryptographically weak by current standards
+var weakIPSecDhGroups = map[int]bool{1: true, 2: true}
+
+// weakIPSecEncryption are encryption algorithms considered cryptographically weak
+var weakIPSecEncryption = map[string]bool{"des-cbc": true, "3des-cbc": true}
+
+// weakIPSecAuthentication are authentication algorithms considered cryptographically weak
+var weakIPSecAuthentication = map[string]bool{"hmac-md5-96": true}
+
+// ikev2OnlyDhGroups require IKEv2 on most peers
+var ikev2OnlyDhGroups = map[int]bool{19: true, 20: true, 24: true}
+
+// gcmEncryption are AEAD ciphers that provide their own integrity and must not be paired with an authentication algorithm
+var gcmEncryption = map[string]bool{"aes-128-gcm": true, "aes-256-gcm": true}
+
 func resourceIBMPIIPSecPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPIIPSecPolicyCreate,
 		ReadContext:   resourceIBMPIIPSecPolicyRead,
 		UpdateContext: resourceIBMPIIPSecPolicyUpdate,
 		DeleteContext: resourceIBMPIIPSecPolicyDelete,
+		CustomizeDiff: resourceIBMPIIPSecPolicyCustomizeDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -76,6 +95,19 @@ func resourceIBMPIIPSecPolicy() *schema.Resource {
 				ValidateFunc: validateAllowedStringValue([]string{"hmac-md5-96", "hmac-sha-256-128", "hmac-sha1-96", "none"}),
 				Description:  "Authentication for the IPSec Policy",
 			},
+			PIVPNPolicyVersion: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2}),
+				Description:  "IKE protocol version this IPSec Policy is paired with",
+			},
+			PIIPSecPolicyAllowWeakCrypto: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to allow weak DH groups and authentication algorithms that are otherwise rejected",
+			},
 
 			//Computed Attributes
 			PIPolicyId: {
@@ -99,6 +131,7 @@ func resourceIBMPIIPSecPolicyCreate(ctx context.Context, d *schema.ResourceData,
 	encryption := d.Get(helpers.PIVPNPolicyEncryption).(string)
 	keyLifetime := int64(d.Get(helpers.PIVPNPolicyKeyLifetime).(int))
 	pfs := d.Get(helpers.PIVPNPolicyPFS).(bool)
+	version := int64(d.Get(PIVPNPolicyVersion).(int))
 
 	body := &models.IPSecPolicyCreate{
 		DhGroup:     &dhGroup,
@@ -106,6 +139,7 @@ func resourceIBMPIIPSecPolicyCreate(ctx context.Context, d *schema.ResourceData,
 		KeyLifetime: models.KeyLifetime(keyLifetime),
 		Name:        &name,
 		Pfs:         &pfs,
+		Version:     &version,
 	}
 
 	if v, ok := d.GetOk(helpers.PIVPNPolicyAuthentication); ok {
@@ -165,6 +199,10 @@ func resourceIBMPIIPSecPolicyUpdate(ctx context.Context, d *schema.ResourceData,
 		authentication := d.Get(helpers.PIVPNPolicyAuthentication).(string)
 		body.Authentication = models.IPSECPolicyAuthentication(authentication)
 	}
+	if d.HasChange(PIVPNPolicyVersion) {
+		version := int64(d.Get(PIVPNPolicyVersion).(int))
+		body.Version = version
+	}
 
 	_, err = client.UpdateIPSecPolicyWithContext(ctx, body, policyID, cloudInstanceID)
 	if err != nil {
@@ -209,6 +247,7 @@ func resourceIBMPIIPSecPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set(helpers.PIVPNPolicyKeyLifetime, ipsecPolicy.KeyLifetime)
 	d.Set(helpers.PIVPNPolicyPFS, ipsecPolicy.Pfs)
 	d.Set(helpers.PIVPNPolicyAuthentication, ipsecPolicy.Authentication)
+	d.Set(PIVPNPolicyVersion, ipsecPolicy.Version)
 
 	return nil
 }
@@ -244,4 +283,41 @@ func resourceIBMPIIPSecPolicyDelete(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId("")
 	return nil
-}
\ No newline at end of file
+}
+
+func resourceIBMPIIPSecPolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateIPSecPolicyCrypto(
+		diff.Get(helpers.PIVPNPolicyEncryption).(string),
+		diff.Get(helpers.PIVPNPolicyAuthentication).(string),
+		diff.Get(helpers.PIVPNPolicyDhGroup).(int),
+		diff.Get(PIVPNPolicyVersion).(int),
+		diff.Get(PIIPSecPolicyAllowWeakCrypto).(bool),
+	)
+}
+
+// validateIPSecPolicyCrypto enforces the algorithm combination rules for an IPSec policy:
+// GCM ciphers must not be paired with a separate authentication algorithm, DH groups 19/20/24
+// require IKEv2, and weak algorithms are rejected unless the caller opts in via allowWeakCrypto.
+func validateIPSecPolicyCrypto(encryption, authentication string, dhGroup, version int, allowWeakCrypto bool) error {
+	if gcmEncryption[encryption] && authentication != "none" {
+		return fmt.Errorf("%s must be \"none\" when %s is %q, GCM ciphers are AEAD and provide their own integrity", helpers.PIVPNPolicyAuthentication, helpers.PIVPNPolicyEncryption, encryption)
+	}
+
+	if ikev2OnlyDhGroups[dhGroup] && version != 2 {
+		return fmt.Errorf("%s %d requires %s to be 2 on most peers", helpers.PIVPNPolicyDhGroup, dhGroup, PIVPNPolicyVersion)
+	}
+
+	if !allowWeakCrypto {
+		if weakIPSecDhGroups[dhGroup] {
+			return fmt.Errorf("%s %d is considered weak; set %s = true to use it anyway", helpers.PIVPNPolicyDhGroup, dhGroup, PIIPSecPolicyAllowWeakCrypto)
+		}
+		if weakIPSecEncryption[encryption] {
+			return fmt.Errorf("%s %q is considered weak; set %s = true to use it anyway", helpers.PIVPNPolicyEncryption, encryption, PIIPSecPolicyAllowWeakCrypto)
+		}
+		if weakIPSecAuthentication[authentication] {
+			return fmt.Errorf("%s %q is considered weak; set %s = true to use it anyway", helpers.PIVPNPolicyAuthentication, authentication, PIIPSecPolicyAllowWeakCrypto)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import "testing"
+
+func TestValidateIPSecPolicyCrypto(t *testing.T) {
+	testCases := []struct {
+		name            string
+		encryption      string
+		authentication  string
+		dhGroup         int
+		version         int
+		allowWeakCrypto bool
+		wantErr         bool
+	}{
+		{
+			name:           "valid defaults",
+			encryption:     "aes-256-cbc",
+			authentication: "hmac-sha-256-128",
+			dhGroup:        14,
+			version:        2,
+		},
+		{
+			name:           "gcm with none authentication is valid",
+			encryption:     "aes-256-gcm",
+			authentication: "none",
+			dhGroup:        14,
+			version:        2,
+		},
+		{
+			name:           "gcm with an authentication algorithm is rejected",
+			encryption:     "aes-256-gcm",
+			authentication: "hmac-sha-256-128",
+			dhGroup:        14,
+			version:        2,
+			wantErr:        true,
+		},
+		{
+			name:           "ikev2-only dh group without version 2 is rejected",
+			encryption:     "aes-256-cbc",
+			authentication: "none",
+			dhGroup:        19,
+			version:        1,
+			wantErr:        true,
+		},
+		{
+			name:           "ikev2-only dh group with version 2 is valid",
+			encryption:     "aes-256-cbc",
+			authentication: "none",
+			dhGroup:        19,
+			version:        2,
+		},
+		{
+			name:           "weak dh group is rejected without opt-in",
+			encryption:     "aes-256-cbc",
+			authentication: "none",
+			dhGroup:        1,
+			version:        2,
+			wantErr:        true,
+		},
+		{
+			name:            "weak dh group is allowed with opt-in",
+			encryption:      "aes-256-cbc",
+			authentication:  "none",
+			dhGroup:         1,
+			version:         2,
+			allowWeakCrypto: true,
+		},
+		{
+			name:           "weak encryption is rejected without opt-in",
+			encryption:     "des-cbc",
+			authentication: "none",
+			dhGroup:        14,
+			version:        2,
+			wantErr:        true,
+		},
+		{
+			name:           "weak authentication is rejected without opt-in",
+			encryption:     "aes-256-cbc",
+			authentication: "hmac-md5-96",
+			dhGroup:        14,
+			version:        2,
+			wantErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateIPSecPolicyCrypto(tc.encryption, tc.authentication, tc.dhGroup, tc.version, tc.allowWeakCrypto)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected an error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+const (
+	// PIVPNConnectionName is the name of the VPN connection
+	PIVPNConnectionName = "pi_connection_name"
+	// PIVPNConnectionIKEPolicyId is the ID of the IKE policy used by the connection
+	PIVPNConnectionIKEPolicyId = "pi_ike_policy_id"
+	// PIVPNConnectionIPSecPolicyId is the ID of the IPSec policy used by the connection
+	PIVPNConnectionIPSecPolicyId = "pi_ipsec_policy_id"
+	// PIVPNConnectionPeerGatewayAddress is the IP address of the peer VPN gateway
+	PIVPNConnectionPeerGatewayAddress = "pi_peer_gateway_address"
+	// PIVPNConnectionPeerSubnets is the list of subnets on the peer side of the tunnel
+	PIVPNConnectionPeerSubnets = "pi_peer_subnets"
+	// PIVPNConnectionLocalSubnets is the list of subnets on the PowerVS side of the tunnel
+	PIVPNConnectionLocalSubnets = "pi_local_subnets"
+	// PIVPNConnectionMode is the IKE negotiation mode, main or aggressive
+	PIVPNConnectionMode = "pi_connection_mode"
+	// PIVPNConnectionInitiator determines whether PowerVS initiates the connection
+	PIVPNConnectionInitiator = "pi_initiator"
+	// PIVPNConnectionMTU is the maximum transmission unit of the tunnel
+	PIVPNConnectionMTU = "pi_mtu"
+	// PIVPNConnectionAdminStateUp enables or disables the connection
+	PIVPNConnectionAdminStateUp = "pi_admin_state_up"
+	// PIVPNConnectionDeadPeerDetection is the Dead Peer Detection configuration block
+	PIVPNConnectionDeadPeerDetection = "pi_dead_peer_detection"
+	// PIVPNConnectionDPDAction is the action taken when a dead peer is detected
+	PIVPNConnectionDPDAction = "action"
+	// PIVPNConnectionDPDInterval is the interval, in seconds, between DPD probes
+	PIVPNConnectionDPDInterval = "interval"
+	// PIVPNConnectionDPDTimeout is the time, in seconds, before a peer is considered dead
+	PIVPNConnectionDPDTimeout = "timeout"
+	// PIVPNConnectionId is the VPN connection ID
+	PIVPNConnectionId = "connection_id"
+	// PIVPNConnectionStatus is the current status of the VPN connection
+	PIVPNConnectionStatus = "status"
+	// PIVPNConnectionTunnelStatus is the current tunnel state of the VPN connection
+	PIVPNConnectionTunnelStatus = "tunnel_status"
+)
+
+func resourceIBMPIVPNConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVPNConnectionCreate,
+		ReadContext:   resourceIBMPIVPNConnectionRead,
+		UpdateContext: resourceIBMPIVPNConnectionUpdate,
+		DeleteContext: resourceIBMPIVPNConnectionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Attributes
+			helpers.PICloudInstanceId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PI cloud instance ID",
+			},
+			PIVPNConnectionName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the VPN connection",
+			},
+			PIVPNConnectionIKEPolicyId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the IKE policy used by this connection",
+			},
+			PIVPNConnectionIPSecPolicyId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the IPSec policy used by this connection",
+			},
+			PIVPNConnectionPeerGatewayAddress: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IP address of the peer VPN gateway",
+			},
+			PIVPNConnectionPeerSubnets: {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of subnets on the peer side of the tunnel",
+			},
+			PIVPNConnectionLocalSubnets: {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of subnets on the PowerVS side of the tunnel",
+			},
+
+			// Optional Attributes
+			PIVPNConnectionMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "main",
+				ValidateFunc: validateAllowedStringValue([]string{"main", "aggressive"}),
+				Description:  "IKE negotiation mode",
+			},
+			PIVPNConnectionInitiator: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "initiator",
+				ValidateFunc: validateAllowedStringValue([]string{"initiator", "response"}),
+				Description:  "Indicates whether PowerVS initiates the connection or only responds to it",
+			},
+			PIVPNConnectionMTU: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1380,
+				Description: "Maximum transmission unit of the tunnel",
+			},
+			PIVPNConnectionAdminStateUp: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables the VPN connection",
+			},
+			PIVPNPresharedKeyCRN: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CRN of a Key Protect or Hyper Protect Crypto Services key holding the preshared key material. The cleartext key is never stored in state",
+			},
+			PIVPNConnectionDeadPeerDetection: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Dead Peer Detection configuration",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						PIVPNConnectionDPDAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "restart",
+							ValidateFunc: validateAllowedStringValue([]string{"clear", "hold", "restart", "disabled"}),
+							Description:  "Action taken when a dead peer is detected",
+						},
+						PIVPNConnectionDPDInterval: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     30,
+							Description: "Interval, in seconds, between DPD probes",
+						},
+						PIVPNConnectionDPDTimeout: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     120,
+							Description: "Time, in seconds, before a peer is considered dead",
+						},
+					},
+				},
+			},
+
+			//Computed Attributes
+			PIVPNConnectionId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "VPN connection ID",
+			},
+			PIVPNConnectionStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the VPN connection",
+			},
+			PIVPNConnectionTunnelStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tunnel state of the VPN connection",
+			},
+			PIVPNPresharedKeyHash: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the preshared key resolved from pi_preshared_key_crn. Only recomputed on create/update, not on every read, so it will not reflect an in-place rotation of the Key Protect key's payload until the next apply",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVPNConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+	name := d.Get(PIVPNConnectionName).(string)
+	ikePolicyID := d.Get(PIVPNConnectionIKEPolicyId).(string)
+	ipsecPolicyID := d.Get(PIVPNConnectionIPSecPolicyId).(string)
+	peerGatewayAddress := d.Get(PIVPNConnectionPeerGatewayAddress).(string)
+	mode := d.Get(PIVPNConnectionMode).(string)
+	initiator := d.Get(PIVPNConnectionInitiator).(string)
+	mtu := int64(d.Get(PIVPNConnectionMTU).(int))
+	adminStateUp := d.Get(PIVPNConnectionAdminStateUp).(bool)
+
+	body := &models.VPNConnectionCreate{
+		Name:               &name,
+		IkePolicy:          &ikePolicyID,
+		IPSecPolicy:        &ipsecPolicyID,
+		PeerGatewayAddress: &peerGatewayAddress,
+		PeerSubnets:        expandStringList(d.Get(PIVPNConnectionPeerSubnets).([]interface{})),
+		NetworkSubnets:     expandStringList(d.Get(PIVPNConnectionLocalSubnets).([]interface{})),
+		Mode:               mode,
+		Initiator:          initiator,
+		Mtu:                mtu,
+		AdminStateUp:       &adminStateUp,
+	}
+
+	if dpdList, ok := d.GetOk(PIVPNConnectionDeadPeerDetection); ok {
+		body.DeadPeerDetection = expandVPNConnectionDPD(dpdList.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(PIVPNPresharedKeyCRN); ok {
+		presharedKey, err := resolvePresharedKeyFromCRN(ctx, meta, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		body.PresharedKey = &presharedKey
+		d.Set(PIVPNPresharedKeyHash, hashPresharedKey(presharedKey))
+	}
+
+	client := st.NewIBMPIVpnConnectionClient(sess, cloudInstanceID)
+	connection, err := client.CreateVPNConnectionWithContext(ctx, body, cloudInstanceID)
+	if err != nil {
+		log.Printf("[DEBUG] create VPN connection failed %v", err)
+		return diag.Errorf(errors.CreateVPNPolicyOperationFailed, cloudInstanceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *connection.ID))
+
+	return resourceIBMPIVPNConnectionRead(ctx, d, meta)
+}
+
+func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := parts[0]
+	connectionID := parts[1]
+
+	client := st.NewIBMPIVpnConnectionClient(sess, cloudInstanceID)
+	body := &models.VPNConnectionUpdate{}
+
+	if d.HasChange(PIVPNConnectionName) {
+		name := d.Get(PIVPNConnectionName).(string)
+		body.Name = name
+	}
+	if d.HasChange(PIVPNConnectionIKEPolicyId) {
+		body.IkePolicy = d.Get(PIVPNConnectionIKEPolicyId).(string)
+	}
+	if d.HasChange(PIVPNConnectionIPSecPolicyId) {
+		body.IPSecPolicy = d.Get(PIVPNConnectionIPSecPolicyId).(string)
+	}
+	if d.HasChange(PIVPNConnectionMode) {
+		body.Mode = d.Get(PIVPNConnectionMode).(string)
+	}
+	if d.HasChange(PIVPNConnectionInitiator) {
+		body.Initiator = d.Get(PIVPNConnectionInitiator).(string)
+	}
+	if d.HasChange(PIVPNConnectionPeerGatewayAddress) {
+		peerGatewayAddress := d.Get(PIVPNConnectionPeerGatewayAddress).(string)
+		body.PeerGatewayAddress = peerGatewayAddress
+	}
+	if d.HasChange(PIVPNConnectionPeerSubnets) {
+		body.PeerSubnets = expandStringList(d.Get(PIVPNConnectionPeerSubnets).([]interface{}))
+	}
+	if d.HasChange(PIVPNConnectionLocalSubnets) {
+		body.NetworkSubnets = expandStringList(d.Get(PIVPNConnectionLocalSubnets).([]interface{}))
+	}
+	if d.HasChange(PIVPNConnectionMTU) {
+		body.Mtu = int64(d.Get(PIVPNConnectionMTU).(int))
+	}
+	if d.HasChange(PIVPNConnectionAdminStateUp) {
+		adminStateUp := d.Get(PIVPNConnectionAdminStateUp).(bool)
+		body.AdminStateUp = &adminStateUp
+	}
+	if d.HasChange(PIVPNConnectionDeadPeerDetection) {
+		body.DeadPeerDetection = expandVPNConnectionDPD(d.Get(PIVPNConnectionDeadPeerDetection).([]interface{}))
+	}
+	if d.HasChange(PIVPNPresharedKeyCRN) {
+		if v, ok := d.GetOk(PIVPNPresharedKeyCRN); ok {
+			presharedKey, err := resolvePresharedKeyFromCRN(ctx, meta, v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			body.PresharedKey = presharedKey
+			d.Set(PIVPNPresharedKeyHash, hashPresharedKey(presharedKey))
+		}
+	}
+
+	_, err = client.UpdateVPNConnectionWithContext(ctx, body, connectionID, cloudInstanceID)
+	if err != nil {
+		return diag.Errorf(errors.UpdateVPNPolicyOperationFailed, connectionID, err)
+	}
+
+	return resourceIBMPIVPNConnectionRead(ctx, d, meta)
+}
+
+func resourceIBMPIVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := parts[0]
+	connectionID := parts[1]
+
+	client := st.NewIBMPIVpnConnectionClient(sess, cloudInstanceID)
+	connection, err := client.GetVPNConnectionWithContext(ctx, connectionID, cloudInstanceID)
+	if err != nil {
+		log.Printf("[DEBUG] get VPN connection failed %v", err)
+		return diag.Errorf(errors.GetCloudConnectionOperationFailed, connectionID, err)
+	}
+
+	d.Set(PIVPNConnectionId, connection.ID)
+	d.Set(PIVPNConnectionName, connection.Name)
+	d.Set(PIVPNConnectionIKEPolicyId, connection.IkePolicy)
+	d.Set(PIVPNConnectionIPSecPolicyId, connection.IPSecPolicy)
+	d.Set(PIVPNConnectionPeerGatewayAddress, connection.PeerGatewayAddress)
+	d.Set(PIVPNConnectionPeerSubnets, connection.PeerSubnets)
+	d.Set(PIVPNConnectionLocalSubnets, connection.NetworkSubnets)
+	d.Set(PIVPNConnectionMode, connection.Mode)
+	d.Set(PIVPNConnectionInitiator, connection.Initiator)
+	d.Set(PIVPNConnectionMTU, connection.Mtu)
+	d.Set(PIVPNConnectionAdminStateUp, connection.AdminStateUp)
+	d.Set(PIVPNConnectionStatus, connection.Status)
+	d.Set(PIVPNConnectionTunnelStatus, connection.TunnelStatus)
+
+	// pi_preshared_key_hash is intentionally not recomputed here: it is set from
+	// Create/Update, where the preshared key is already fetched from Key Protect
+	// to build the API request body. Refetching it on every plan/refresh would add
+	// a Key Protect round trip (and failure mode) to routine reads.
+
+	return nil
+}
+
+func resourceIBMPIVPNConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := parts[0]
+	connectionID := parts[1]
+
+	client := st.NewIBMPIVpnConnectionClient(sess, cloudInstanceID)
+
+	err = client.DeleteVPNConnectionWithContext(ctx, connectionID, cloudInstanceID)
+	if err != nil {
+		log.Printf("[DEBUG] delete VPN connection failed %v", err)
+		return diag.Errorf(errors.DeleteVPNPolicyOperationFailed, connectionID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandVPNConnectionDPD(dpdList []interface{}) *models.VPNConnectionDeadPeerDetection {
+	if len(dpdList) == 0 || dpdList[0] == nil {
+		return nil
+	}
+
+	dpdMap := dpdList[0].(map[string]interface{})
+	dpd := &models.VPNConnectionDeadPeerDetection{
+		Action:   dpdMap[PIVPNConnectionDPDAction].(string),
+		Interval: int64(dpdMap[PIVPNConnectionDPDInterval].(int)),
+		Timeout:  int64(dpdMap[PIVPNConnectionDPDTimeout].(int)),
+	}
+
+	return dpd
+}